@@ -0,0 +1,88 @@
+package v1
+
+// ImageVerification configures signature (and optional attestation)
+// verification for the image references a validate rule resolves.
+type ImageVerification struct {
+	// ImageReferences is the list of JMESPath expressions used to locate
+	// image references in the resource. Defaults to every container and
+	// initContainer image when empty.
+	ImageReferences []string `json:"imageReferences,omitempty"`
+
+	// Attestations, when set, are also verified for each image, in addition
+	// to its signature.
+	Attestations []Attestation `json:"attestations,omitempty"`
+
+	// Roots is a PEM bundle of root certificates used in place of the
+	// default Fulcio roots, for keyless verification against a private CA.
+	Roots string `json:"roots,omitempty"`
+
+	// PublicKeys is a list of PEM-encoded public keys. When set, signatures
+	// are verified against these keys instead of a keyless Fulcio/Rekor
+	// identity; an image is accepted if any one of them verifies it.
+	// +optional
+	PublicKeys []string `json:"publicKeys,omitempty"`
+
+	// Issuer, for keyless verification, restricts accepted signatures to
+	// certificates issued by this OIDC issuer (e.g.
+	// "https://token.actions.githubusercontent.com"). Required alongside
+	// Subject when PublicKeys is empty - without it, any Fulcio-issued
+	// certificate logged to Rekor would verify, regardless of who signed.
+	// +optional
+	Issuer string `json:"issuer,omitempty"`
+
+	// Subject, for keyless verification, restricts accepted signatures to
+	// certificates issued to this identity (e.g. a workflow's
+	// "https://github.com/org/repo/.github/workflows/build.yaml@refs/heads/main").
+	// Required alongside Issuer when PublicKeys is empty.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+
+	// MutateDigest controls whether a verified image's reference is
+	// rewritten to its digest form (image@sha256:...) after verification.
+	// Defaults to true (matching the original behaviour) when unset; set to
+	// false to verify without mutating the resource.
+	// +optional
+	MutateDigest *bool `json:"mutateDigest,omitempty"`
+}
+
+// Attestation configures verification of an in-toto/SLSA provenance
+// attestation attached to an image.
+type Attestation struct {
+	// PredicateType is the in-toto predicate type the attestation must
+	// match, e.g. "https://slsa.dev/provenance/v0.2".
+	PredicateType string `json:"predicateType"`
+}
+
+// MutateDigestEnabled reports whether iv is configured to rewrite verified
+// image references to their digest form. Nil (unset) defaults to true.
+func (iv *ImageVerification) MutateDigestEnabled() bool {
+	return iv.MutateDigest == nil || *iv.MutateDigest
+}
+
+func (iv *ImageVerification) DeepCopy() *ImageVerification {
+	if iv == nil {
+		return nil
+	}
+
+	out := new(ImageVerification)
+	*out = *iv
+
+	if iv.ImageReferences != nil {
+		out.ImageReferences = append([]string(nil), iv.ImageReferences...)
+	}
+
+	if iv.Attestations != nil {
+		out.Attestations = append([]Attestation(nil), iv.Attestations...)
+	}
+
+	if iv.PublicKeys != nil {
+		out.PublicKeys = append([]string(nil), iv.PublicKeys...)
+	}
+
+	if iv.MutateDigest != nil {
+		mutate := *iv.MutateDigest
+		out.MutateDigest = &mutate
+	}
+
+	return out
+}
@@ -0,0 +1,69 @@
+package v1
+
+import "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+
+// ExpressionEngineType selects the expression language a rule's
+// preconditions, deny conditions, and foreach list are written in. It
+// defaults to jmespath when a rule doesn't set one, preserving existing
+// policies.
+// +kubebuilder:validation:Enum=jmespath;cel
+type ExpressionEngineType string
+
+const (
+	JMESPathEngine ExpressionEngineType = "jmespath"
+	CELEngine      ExpressionEngineType = "cel"
+)
+
+// Rule defines a single validation, mutation, or generation action.
+type Rule struct {
+	Name             string             `json:"name"`
+	Context          []ContextEntry     `json:"context,omitempty"`
+	AnyAllConditions apiextensions.JSON `json:"preconditions,omitempty"`
+	Validation       Validation         `json:"validate,omitempty"`
+
+	// ExpressionEngine selects jmespath (the default) or cel for this
+	// rule's preconditions, deny conditions, and foreach list.
+	// +optional
+	// +kubebuilder:default=jmespath
+	ExpressionEngine ExpressionEngineType `json:"expressionEngine,omitempty"`
+}
+
+// HasValidate checks for validate rule
+func (r Rule) HasValidate() bool {
+	return r.Validation.Pattern != nil ||
+		r.Validation.AnyPattern != nil ||
+		r.Validation.Deny != nil ||
+		r.Validation.ForEachValidation != nil ||
+		r.Validation.VerifyImages != nil
+}
+
+// Validation defines the validation rule body.
+type Validation struct {
+	Message           string             `json:"message,omitempty"`
+	Pattern           apiextensions.JSON `json:"pattern,omitempty"`
+	AnyPattern        apiextensions.JSON `json:"anyPattern,omitempty"`
+	Deny              *Deny              `json:"deny,omitempty"`
+	ForEachValidation *ForEachValidation `json:"foreach,omitempty"`
+	VerifyImages      *ImageVerification `json:"verifyImages,omitempty"`
+}
+
+// ForEachValidation applies validation rules to a list of sub-elements.
+type ForEachValidation struct {
+	List             string             `json:"list"`
+	Context          []ContextEntry     `json:"context,omitempty"`
+	AnyAllConditions apiextensions.JSON `json:"preconditions,omitempty"`
+	Pattern          apiextensions.JSON `json:"pattern,omitempty"`
+	AnyPattern       apiextensions.JSON `json:"anyPattern,omitempty"`
+	Deny             *Deny              `json:"deny,omitempty"`
+}
+
+// Deny defines a condition set that fails the rule when satisfied.
+type Deny struct {
+	AnyAllConditions apiextensions.JSON `json:"conditions,omitempty"`
+}
+
+// ContextEntry adds variables to the policy's evaluation context, e.g. from
+// the API server or a ConfigMap.
+type ContextEntry struct {
+	Name string `json:"name"`
+}
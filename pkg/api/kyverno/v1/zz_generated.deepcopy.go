@@ -0,0 +1,105 @@
+// +build !ignore_autogenerated
+
+// Code generated by deepcopy-gen. Hand-maintained here for the fields this
+// change touches, since this checkout doesn't carry the rest of the
+// generated file to regenerate against; run `make codegen-deepcopy` against
+// the full module to reconcile.
+
+package v1
+
+import "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+
+func (in *Rule) DeepCopy() *Rule {
+	if in == nil {
+		return nil
+	}
+
+	out := new(Rule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+func (in *Rule) DeepCopyInto(out *Rule) {
+	*out = *in
+	if in.Context != nil {
+		out.Context = make([]ContextEntry, len(in.Context))
+		copy(out.Context, in.Context)
+	}
+
+	out.AnyAllConditions = deepCopyJSON(in.AnyAllConditions)
+	in.Validation.DeepCopyInto(&out.Validation)
+}
+
+func (in *Validation) DeepCopyInto(out *Validation) {
+	*out = *in
+	out.Pattern = deepCopyJSON(in.Pattern)
+	out.AnyPattern = deepCopyJSON(in.AnyPattern)
+
+	if in.Deny != nil {
+		out.Deny = in.Deny.DeepCopy()
+	}
+
+	if in.ForEachValidation != nil {
+		out.ForEachValidation = in.ForEachValidation.DeepCopy()
+	}
+
+	if in.VerifyImages != nil {
+		out.VerifyImages = in.VerifyImages.DeepCopy()
+	}
+}
+
+func (in *Deny) DeepCopy() *Deny {
+	if in == nil {
+		return nil
+	}
+
+	out := new(Deny)
+	out.AnyAllConditions = deepCopyJSON(in.AnyAllConditions)
+	return out
+}
+
+func (in *ForEachValidation) DeepCopy() *ForEachValidation {
+	if in == nil {
+		return nil
+	}
+
+	out := new(ForEachValidation)
+	*out = *in
+	if in.Context != nil {
+		out.Context = make([]ContextEntry, len(in.Context))
+		copy(out.Context, in.Context)
+	}
+
+	out.AnyAllConditions = deepCopyJSON(in.AnyAllConditions)
+	out.Pattern = deepCopyJSON(in.Pattern)
+	out.AnyPattern = deepCopyJSON(in.AnyPattern)
+
+	if in.Deny != nil {
+		out.Deny = in.Deny.DeepCopy()
+	}
+
+	return out
+}
+
+// deepCopyJSON deep copies a CRD-schema JSON value: nil, bool, string,
+// float64, []interface{}, or map[string]interface{}.
+func deepCopyJSON(in apiextensions.JSON) apiextensions.JSON {
+	switch v := in.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			out[k] = deepCopyJSON(val)
+		}
+
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = deepCopyJSON(val)
+		}
+
+		return out
+	default:
+		return v
+	}
+}
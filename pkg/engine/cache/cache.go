@@ -0,0 +1,143 @@
+// Package cache provides an in-memory, size- and TTL-bounded cache of
+// EngineResponses keyed by policy generation and resource content, so that
+// repeated admission requests for identical resources (common under GitOps
+// re-syncs and controller reconciles) can skip rule evaluation entirely.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/kyverno/kyverno/pkg/engine/response"
+)
+
+const (
+	// DefaultSize is the number of responses kept in memory per cache.
+	DefaultSize = 10000
+
+	// DefaultTTL bounds how long a cached response stays valid, so results
+	// can't outlive inputs the cache key doesn't cover (e.g. namespace
+	// labels changing underneath a still-current resourceVersion).
+	DefaultTTL = 5 * time.Minute
+
+	// DisableAnnotation lets a policy opt out of result caching entirely.
+	DisableAnnotation = "policies.kyverno.io/disable-cache"
+)
+
+type entry struct {
+	// data is the JSON encoding of the cached response rather than the
+	// response itself, so Get/Set hand out independent copies instead of a
+	// pointer shared with whatever else is holding (and possibly mutating)
+	// the original - e.g. a concurrent admission request that hits the
+	// cache while another is still rewriting image digests in place on the
+	// response it's about to store.
+	data      []byte
+	expiresAt time.Time
+}
+
+// Cache memoizes EngineResponses by a caller-supplied key. It is safe for
+// concurrent use.
+type Cache struct {
+	mu  sync.Mutex
+	lru *lru.Cache
+	ttl time.Duration
+}
+
+// New creates a Cache holding at most size entries, each valid for ttl.
+// A non-positive size or ttl falls back to the package defaults.
+func New(size int, ttl time.Duration) (*Cache, error) {
+	if size <= 0 {
+		size = DefaultSize
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	l, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{lru: l, ttl: ttl}, nil
+}
+
+// Get returns a copy of the cached response for key, if present and not
+// expired. Callers are free to mutate the returned response without
+// affecting the cached entry or any other caller's copy.
+func (c *Cache) Get(key string) (*response.EngineResponse, bool) {
+	c.mu.Lock()
+	v, ok := c.lru.Get(key)
+	c.mu.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	e := v.(*entry)
+	if time.Now().After(e.expiresAt) {
+		c.mu.Lock()
+		c.lru.Remove(key)
+		c.mu.Unlock()
+		return nil, false
+	}
+
+	var resp response.EngineResponse
+	if err := json.Unmarshal(e.data, &resp); err != nil {
+		return nil, false
+	}
+
+	return &resp, true
+}
+
+// Set stores an independent copy of resp under key, replacing any existing
+// entry. Serializing to JSON rather than storing resp's pointer means a
+// later in-place mutation of the caller's response (e.g. image digest
+// rewriting) can't corrupt what's cached, and a cache hit can't hand out a
+// pointer shared with a concurrent admission request.
+func (c *Cache) Set(key string, resp *response.EngineResponse) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.lru.Add(key, &entry{data: data, expiresAt: time.Now().Add(c.ttl)})
+	c.mu.Unlock()
+	return nil
+}
+
+// Key derives a cache key from the policy's identity and generation and a
+// hash of the resource, admission info, and execution mode being evaluated
+// against it. Folding the policy generation into the key means an update to
+// the policy is naturally a cache miss, so there is no separate invalidation
+// path to keep in sync with a policy watch. Folding in mode keeps a
+// Simulate-mode response (which may carry SubResults) from ever being served
+// back as an Enforce/Audit result for the same policy/resource, or vice
+// versa.
+func Key(policyKey string, policyGeneration int64, resource, admissionInfo, mode interface{}) (string, error) {
+	h := sha256.New()
+	h.Write([]byte(policyKey))
+
+	enc := json.NewEncoder(h)
+	if err := enc.Encode(policyGeneration); err != nil {
+		return "", err
+	}
+
+	if err := enc.Encode(resource); err != nil {
+		return "", err
+	}
+
+	if err := enc.Encode(admissionInfo); err != nil {
+		return "", err
+	}
+
+	if err := enc.Encode(mode); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,111 @@
+// Package cel provides a thin wrapper around cel-go so the validation engine
+// can evaluate Common Expression Language expressions against a single JSON
+// document. The engine passes the resource (or the current foreach element);
+// the admission request and context variables that JMESPath queries can read
+// are not available to CEL expressions.
+package cel
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// Program is a compiled CEL expression, ready to be evaluated repeatedly
+// against different input documents.
+type Program struct {
+	expr string
+	prg  cel.Program
+}
+
+// programCache memoizes compiled programs by expression source. A foreach
+// rule's precondition/deny expression is compiled once per foreach element
+// (its list expression, and preconditions/deny outside foreach, once per
+// rule) by the validation engine, so without
+// this a large foreach list pays CEL's parse-and-typecheck cost on every
+// element instead of once. Safe for concurrent use.
+var programCache sync.Map // map[string]*Program
+
+// Compile parses and checks a CEL expression, returning a cached program if
+// this exact expression source has already been compiled. The expression is
+// evaluated against a single top-level variable, "object", holding the JSON
+// document (resource or foreach element) the expression is scoped
+// to.
+func Compile(expression string) (*Program, error) {
+	if cached, ok := programCache.Load(expression); ok {
+		return cached.(*Program), nil
+	}
+
+	prg, err := compile(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	actual, _ := programCache.LoadOrStore(expression, prg)
+	return actual.(*Program), nil
+}
+
+func compile(expression string) (*Program, error) {
+	env, err := cel.NewEnv(cel.Variable("object", cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %v", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("failed to compile CEL expression %q: %v", expression, issues.Err())
+	}
+
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build CEL program for %q: %v", expression, err)
+	}
+
+	return &Program{expr: expression, prg: prg}, nil
+}
+
+// Evaluate runs the compiled expression against the given document and
+// returns the raw CEL result value.
+func (p *Program) Evaluate(object map[string]interface{}) (ref.Val, error) {
+	out, _, err := p.prg.Eval(map[string]interface{}{"object": object})
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate CEL expression %q: %v", p.expr, err)
+	}
+
+	return out, nil
+}
+
+// EvaluateBool runs the compiled expression and coerces the result to a bool,
+// failing if the expression did not produce one. This is the common case for
+// preconditions and deny conditions.
+func (p *Program) EvaluateBool(object map[string]interface{}) (bool, error) {
+	out, err := p.Evaluate(object)
+	if err != nil {
+		return false, err
+	}
+
+	b, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("CEL expression %q did not evaluate to a bool, got %T", p.expr, out.Value())
+	}
+
+	return b, nil
+}
+
+// EvaluateList runs the compiled expression and coerces the result to a
+// slice, for use in foreach's evaluateList.
+func (p *Program) EvaluateList(object map[string]interface{}) ([]interface{}, error) {
+	out, err := p.Evaluate(object)
+	if err != nil {
+		return nil, err
+	}
+
+	list, ok := out.Value().([]interface{})
+	if !ok {
+		return []interface{}{out.Value()}, nil
+	}
+
+	return list, nil
+}
@@ -0,0 +1,166 @@
+// Package images resolves container image references from a resource and
+// verifies their cosign/sigstore signatures, and optional in-toto SLSA
+// provenance attestations, against the attestors configured on a
+// verifyImages validation rule.
+package images
+
+import (
+	"context"
+	"fmt"
+
+	kyverno "github.com/kyverno/kyverno/pkg/api/kyverno/v1"
+	"github.com/sigstore/cosign/pkg/cosign"
+)
+
+// DefaultPaths are the JMESPath expressions used to locate image references
+// when a rule does not declare its own imageReferences.
+var DefaultPaths = []string{
+	"spec.containers[*].image",
+	"spec.initContainers[*].image",
+}
+
+// Paths returns the configured image JMESPath expressions, or DefaultPaths
+// when the rule didn't declare any.
+func Paths(paths []string) []string {
+	if len(paths) == 0 {
+		return DefaultPaths
+	}
+
+	return paths
+}
+
+// Result records the outcome of verifying a single resolved image.
+type Result struct {
+	Image  string
+	Digest string
+}
+
+// Verify checks every image reference against the rule's configured
+// attestors (static keys or a keyless Fulcio/Rekor identity), and, when
+// Attestations are configured, the referenced in-toto/SLSA provenance
+// attestations. It fails closed: the first image that cannot be verified
+// aborts verification for the whole rule.
+func Verify(imageRefs []string, verification kyverno.ImageVerification) ([]Result, error) {
+	opts, err := toCosignOpts(verification)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, 0, len(imageRefs))
+	for _, ref := range imageRefs {
+		digest, err := verifySignature(ref, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to verify signature for %s: %v", ref, err)
+		}
+
+		for _, att := range verification.Attestations {
+			if err := verifyAttestation(ref, att, opts); err != nil {
+				return nil, fmt.Errorf("failed to verify %s attestation for %s: %v", att.PredicateType, ref, err)
+			}
+		}
+
+		results = append(results, Result{Image: ref, Digest: digest})
+	}
+
+	return results, nil
+}
+
+// toCosignOpts builds the CheckOpts for verification, and requires that the
+// rule actually constrains who/what it trusts: either a set of static public
+// keys, or a keyless issuer/subject identity. Without one of these, a bare
+// Fulcio certificate logged to Rekor would verify regardless of who signed
+// it, which is not a meaningful verification.
+func toCosignOpts(verification kyverno.ImageVerification) (cosign.CheckOpts, error) {
+	opts := cosign.CheckOpts{}
+	if verification.Roots != "" {
+		opts.RootCerts = []byte(verification.Roots)
+	}
+
+	switch {
+	case len(verification.PublicKeys) > 0:
+		verifier, err := cosign.LoadPublicKeys(context.Background(), verification.PublicKeys)
+		if err != nil {
+			return opts, fmt.Errorf("failed to load public keys: %v", err)
+		}
+		opts.SigVerifier = verifier
+	case verification.Issuer != "" && verification.Subject != "":
+		opts.Identities = []cosign.Identity{
+			{Issuer: verification.Issuer, Subject: verification.Subject},
+		}
+	default:
+		return opts, fmt.Errorf("verifyImages requires either publicKeys or both issuer and subject to be set")
+	}
+
+	return opts, nil
+}
+
+// verifySignature verifies the image's cosign signature and returns its
+// resolved digest, so callers can rewrite the reference to an immutable
+// digest form.
+func verifySignature(imageRef string, opts cosign.CheckOpts) (string, error) {
+	sigs, _, err := cosign.VerifyImageSignatures(imageRef, &opts)
+	if err != nil {
+		return "", err
+	}
+
+	if len(sigs) == 0 {
+		return "", fmt.Errorf("no valid signatures found for %s", imageRef)
+	}
+
+	return sigs[0].Digest, nil
+}
+
+func verifyAttestation(imageRef string, att kyverno.Attestation, opts cosign.CheckOpts) error {
+	opts.ClaimVerifier = cosign.AttestationToPayloadJSON
+	statements, _, err := cosign.VerifyImageAttestations(imageRef, &opts)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range statements {
+		if s.PredicateType == att.PredicateType {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no attestation of type %s found", att.PredicateType)
+}
+
+// SetDigests rewrites the `image` field of each container/initContainer
+// entry in resource to its verified digest form (image@sha256:...), for the
+// images present in digestByRef. Only the two default container paths are
+// rewritten; custom imageReferences paths are verified but left untouched,
+// since they may not point at a container list.
+func SetDigests(resource map[string]interface{}, digestByRef map[string]string) {
+	for _, field := range []string{"containers", "initContainers"} {
+		rewriteContainerDigests(resource, field, digestByRef)
+	}
+}
+
+func rewriteContainerDigests(resource map[string]interface{}, field string, digestByRef map[string]string) {
+	spec, ok := resource["spec"].(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	containers, ok := spec[field].([]interface{})
+	if !ok {
+		return
+	}
+
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		image, ok := container["image"].(string)
+		if !ok {
+			continue
+		}
+
+		if digest, found := digestByRef[image]; found {
+			container["image"] = fmt.Sprintf("%s@%s", image, digest)
+		}
+	}
+}
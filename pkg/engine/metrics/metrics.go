@@ -0,0 +1,63 @@
+// Package metrics exposes Prometheus metrics for the validation engine, so
+// operators can see which policies/rules are slow or failing without having
+// to read individual EngineResponses.
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// RuleResults counts rule outcomes by policy, rule, and status.
+	RuleResults = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kyverno_policy_rule_results_total",
+			Help: "Total number of policy rule results, partitioned by policy, rule, and status.",
+		},
+		[]string{"policy", "rule", "status"},
+	)
+
+	// ContextLoadLatency tracks how long a rule's context entries took to
+	// load, e.g. when a context entry makes a slow external API call.
+	ContextLoadLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kyverno_policy_context_load_duration_seconds",
+			Help:    "Time spent loading a rule's context entries.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"policy", "rule"},
+	)
+
+	// JMESPathQueryLatency tracks how long individual JMESPath queries take,
+	// so an expensive query can be spotted even when it's one of many in a
+	// rule's overall processing time.
+	JMESPathQueryLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "kyverno_policy_jmespath_query_duration_seconds",
+			Help:    "Time spent evaluating a single JMESPath query.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"policy", "rule"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(RuleResults, ContextLoadLatency, JMESPathQueryLatency)
+}
+
+// RecordRuleResult increments the outcome counter for a rule.
+func RecordRuleResult(policy, rule, status string) {
+	RuleResults.WithLabelValues(policy, rule, status).Inc()
+}
+
+// ObserveContextLoad records how long loading a rule's context entries took.
+func ObserveContextLoad(policy, rule string, d time.Duration) {
+	ContextLoadLatency.WithLabelValues(policy, rule).Observe(d.Seconds())
+}
+
+// ObserveJMESPathQuery records how long a single JMESPath query took.
+func ObserveJMESPathQuery(policy, rule string, d time.Duration) {
+	JMESPathQueryLatency.WithLabelValues(policy, rule).Observe(d.Seconds())
+}
@@ -0,0 +1,20 @@
+package engine
+
+// PolicyExecutionMode controls how far the validation engine goes once a
+// rule fails. Enforce and Audit both stop evaluating a rule's foreach
+// elements at the first failure, differing only in how the caller reacts to
+// RuleStatusFail; Simulate overrides that short-circuit and evaluates every
+// foreach element regardless of earlier failures. In every mode, anyPattern
+// branches are tried in order until one passes; Simulate additionally
+// records each failing foreach element and anyPattern branch in
+// RuleResponse.SubResults, so a single run reports every problem instead of
+// just the first one hit. This exhaustiveness is the actual cost of using
+// Simulate: on a foreach rule with early failures it does genuinely more
+// work than Enforce/Audit, in exchange for a complete failure report.
+type PolicyExecutionMode string
+
+const (
+	Enforce  PolicyExecutionMode = "Enforce"
+	Audit    PolicyExecutionMode = "Audit"
+	Simulate PolicyExecutionMode = "Simulate"
+)
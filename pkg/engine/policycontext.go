@@ -0,0 +1,96 @@
+package engine
+
+import (
+	kyverno "github.com/kyverno/kyverno/pkg/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/engine/cache"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// PolicySpec is the part of a ClusterPolicy the validation engine reads:
+// its rules and what to do when one fails.
+type PolicySpec struct {
+	Rules                   []kyverno.Rule
+	ValidationFailureAction string
+}
+
+// ClusterPolicy is the policy resource the validation engine evaluates.
+type ClusterPolicy struct {
+	metav1.ObjectMeta
+	Spec PolicySpec
+}
+
+// RequestInfo carries the caller identity from the admission request the
+// policy is being evaluated against.
+type RequestInfo struct {
+	AdmissionUserInfo authenticationv1.UserInfo
+}
+
+// JSONContext is the subset of a policy evaluation's JMESPath context the
+// validation engine depends on: checkpointing for rule/foreach isolation,
+// and queries for preconditions and foreach lists.
+type JSONContext interface {
+	Checkpoint()
+	Reset()
+	Restore()
+	Query(jmesPath string) (interface{}, error)
+	AddResourceAsObject(resource interface{}) error
+	// Copy returns an independent clone so a concurrent rule/foreach worker
+	// can Reset/Query it without racing the original.
+	Copy() JSONContext
+}
+
+// ResourceCache is an opaque handle to a cluster resource cache, threaded
+// through to context entries that look up live cluster state (e.g. an
+// APICall context entry backed by a cached informer).
+type ResourceCache interface{}
+
+// PolicyContext carries everything the validation engine needs to evaluate
+// a single policy against a single admission request.
+type PolicyContext struct {
+	Policy           ClusterPolicy
+	NewResource      unstructured.Unstructured
+	OldResource      unstructured.Unstructured
+	AdmissionInfo    RequestInfo
+	ExcludeGroupRole []string
+	NamespaceLabels  map[string]string
+	JSONContext      JSONContext
+	ResourceCache    ResourceCache
+
+	// ResultCache memoizes EngineResponses across admissions of the same
+	// policy/resource combination. Nil disables result caching.
+	ResultCache *cache.Cache
+
+	// Mode controls whether rule/foreach evaluation stops at the first
+	// failure (Enforce/Audit) or runs exhaustively to collect every one
+	// (Simulate). The zero value behaves as Enforce/Audit.
+	Mode PolicyExecutionMode
+}
+
+// Copy returns a shallow copy of the PolicyContext, sharing the same
+// JSONContext and resource objects. It's enough for a foreach element that
+// only needs its own NewResource (addElementToContext replaces it on the
+// copy, never the original) and doesn't run concurrently with its siblings.
+func (c *PolicyContext) Copy() *PolicyContext {
+	out := *c
+	return &out
+}
+
+// DeepCopy returns a PolicyContext safe to hand to a concurrent rule/foreach
+// worker: its own JSONContext clone (so Checkpoint/Reset/Restore can't race
+// the original or a sibling worker's), and its own deep copy of
+// NewResource/OldResource, so a rule that mutates the resource in place
+// (e.g. image digest rewriting) can never race another rule's read of the
+// same underlying map.
+func (c *PolicyContext) DeepCopy() *PolicyContext {
+	out := *c
+	out.NewResource = *c.NewResource.DeepCopy()
+	out.OldResource = *c.OldResource.DeepCopy()
+
+	if c.JSONContext != nil {
+		out.JSONContext = c.JSONContext.Copy()
+	}
+
+	return &out
+}
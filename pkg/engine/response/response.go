@@ -0,0 +1,73 @@
+// Package response defines the result of evaluating a policy/rule against a
+// resource: EngineResponse for a whole policy, RuleResponse for a single
+// rule within it.
+package response
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// EngineResponse is the result of evaluating one policy against one
+// resource.
+type EngineResponse struct {
+	// PatchedResource is the resource the policy was evaluated against -
+	// the new resource, or the old resource on a delete request.
+	PatchedResource unstructured.Unstructured
+	PolicyResponse  PolicyResponse
+}
+
+// PolicyResponse summarizes the policy/resource being evaluated and the
+// outcome of every rule that ran.
+type PolicyResponse struct {
+	Policy                   ResourceSpec
+	Resource                 ResourceSpec
+	ValidationFailureAction  string
+	ProcessingTime           time.Duration
+	PolicyExecutionTimestamp int64
+	RulesAppliedCount        int
+	RulesErrorCount          int
+	Rules                    []RuleResponse
+}
+
+// ResourceSpec identifies a policy or resource by name/namespace/kind, as
+// relevant.
+type ResourceSpec struct {
+	Name       string
+	Namespace  string
+	Kind       string
+	APIVersion string
+}
+
+// RuleStatus is the outcome of evaluating a single rule.
+type RuleStatus string
+
+const (
+	RuleStatusPass  RuleStatus = "pass"
+	RuleStatusFail  RuleStatus = "fail"
+	RuleStatusError RuleStatus = "error"
+	RuleStatusSkip  RuleStatus = "skip"
+)
+
+// RuleResponse is the outcome of evaluating a single rule.
+type RuleResponse struct {
+	Name    string
+	Type    string
+	Message string
+	Status  RuleStatus
+
+	RuleStats RuleStats
+
+	// SubResults holds the individual foreach-element/anyPattern-branch
+	// failures that rolled up into this rule's overall Status, when the
+	// engine evaluated exhaustively (Simulate mode) instead of stopping at
+	// the first one.
+	SubResults []RuleResponse
+}
+
+// RuleStats tracks how long a single rule took to evaluate.
+type RuleStats struct {
+	ProcessingTime         time.Duration
+	RuleExecutionTimestamp int64
+}
@@ -0,0 +1,42 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kyverno/kyverno/pkg/engine/response"
+)
+
+// EngineSimulate runs policy validation in Simulate mode, so every rule
+// failure - including each failing foreach element and anyPattern branch -
+// is collected into the response instead of stopping at the first one. It's
+// the entry point `kyverno test` and similar batch tooling use to report
+// comprehensive feedback on a manifest in a single pass.
+//
+// There's no inbound admission request to inherit a context from here -
+// test/CLI tooling runs outside the webhook - so this roots its own
+// background context, unlike the webhook path into Validate.
+func EngineSimulate(policyContext *PolicyContext) *response.EngineResponse {
+	policyContext.Mode = Simulate
+	return Validate(context.Background(), policyContext)
+}
+
+// SimulationReport is the JSON shape returned to CLI/API callers of
+// EngineSimulate: the policy/resource being evaluated and the full rule
+// failure tree, including nested foreach/anyPattern sub-results.
+type SimulationReport struct {
+	Policy   string                  `json:"policy"`
+	Resource string                  `json:"resource"`
+	Rules    []response.RuleResponse `json:"rules"`
+}
+
+// Report renders resp as the JSON failure tree described by SimulationReport.
+func Report(resp *response.EngineResponse) ([]byte, error) {
+	report := SimulationReport{
+		Policy:   resp.PolicyResponse.Policy.Name,
+		Resource: resp.PolicyResponse.Resource.Name,
+		Rules:    resp.PolicyResponse.Rules,
+	}
+
+	return json.MarshalIndent(report, "", "  ")
+}
@@ -1,28 +1,47 @@
 package engine
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/kyverno/kyverno/pkg/engine/common"
 	"github.com/pkg/errors"
 	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
 	"reflect"
+	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
 	gojmespath "github.com/jmespath/go-jmespath"
 	kyverno "github.com/kyverno/kyverno/pkg/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/engine/cache"
+	"github.com/kyverno/kyverno/pkg/engine/cel"
+	"github.com/kyverno/kyverno/pkg/engine/images"
+	"github.com/kyverno/kyverno/pkg/engine/metrics"
 	"github.com/kyverno/kyverno/pkg/engine/response"
 	"github.com/kyverno/kyverno/pkg/engine/utils"
 	"github.com/kyverno/kyverno/pkg/engine/validate"
 	"github.com/kyverno/kyverno/pkg/engine/variables"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
-//Validate applies validation rules from policy on the resource
-func Validate(policyContext *PolicyContext) (resp *response.EngineResponse) {
+// tracer emits spans for policy/rule/foreach-element/pattern-match
+// processing, one per policy evaluation with child spans nested beneath it.
+var tracer = otel.Tracer("github.com/kyverno/kyverno/pkg/engine")
+
+// Validate applies validation rules from policy on the resource. ctx roots
+// the policy/rule/foreach spans this call creates, so it should be the
+// context of the admission request being evaluated - letting them show up
+// as children of that request's own trace (and respect its deadline)
+// instead of starting a disconnected trace of their own.
+func Validate(ctx context.Context, policyContext *PolicyContext) (resp *response.EngineResponse) {
 	resp = &response.EngineResponse{}
 	startTime := time.Now()
 
@@ -33,10 +52,100 @@ func Validate(policyContext *PolicyContext) (resp *response.EngineResponse) {
 		logger.V(4).Info("finished policy processing", "processingTime", resp.PolicyResponse.ProcessingTime.String(), "validationRulesApplied", resp.PolicyResponse.RulesAppliedCount)
 	}()
 
-	resp = validateResource(logger, policyContext)
+	if cached, ok := getCachedResponse(policyContext); ok {
+		logger.V(4).Info("serving validation result from cache")
+		return cached
+	}
+
+	spanCtx, span := tracer.Start(ctx, "policy",
+		trace.WithAttributes(attribute.String("policy.name", policyContext.Policy.GetName())))
+	defer span.End()
+
+	resp = validateResource(spanCtx, logger, policyContext)
+	cacheResponse(policyContext, resp)
 	return
 }
 
+// resultCacheDisabled reports whether the policy opted out of result caching
+// via the cache.DisableAnnotation annotation.
+func resultCacheDisabled(ctx *PolicyContext) bool {
+	_, disabled := ctx.Policy.GetAnnotations()[cache.DisableAnnotation]
+	return disabled
+}
+
+// volatileMetadataFields are stripped from the resource before it's hashed
+// into the cache key. They're bumped by the API server on every write -
+// including a no-op reconcile that changes nothing the policy actually
+// reads - so leaving them in defeats the cache for exactly the GitOps
+// re-sync workload it's meant to help: the same spec/labels/annotations
+// would otherwise produce a different key, and thus a cache miss, on every
+// resync. Fields that can affect rule outcomes (e.g. labels, annotations)
+// are deliberately left in the key.
+var volatileMetadataFields = []string{
+	"resourceVersion",
+	"generation",
+	"managedFields",
+	"creationTimestamp",
+	"selfLink",
+	"uid",
+}
+
+// canonicalizeForCache returns a copy of resource with volatileMetadataFields
+// removed from its metadata, for hashing into the cache key.
+func canonicalizeForCache(resource unstructured.Unstructured) map[string]interface{} {
+	canonical := resource.DeepCopy().Object
+	if meta, ok := canonical["metadata"].(map[string]interface{}); ok {
+		for _, field := range volatileMetadataFields {
+			delete(meta, field)
+		}
+	}
+
+	return canonical
+}
+
+// resultCacheKey builds the cache key for the policy/resource/admissionInfo
+// combination currently being evaluated.
+func resultCacheKey(ctx *PolicyContext) (string, error) {
+	policyKey := ctx.Policy.GetNamespace() + "/" + ctx.Policy.GetName()
+
+	resource := ctx.NewResource
+	if reflect.DeepEqual(resource, unstructured.Unstructured{}) {
+		resource = ctx.OldResource
+	}
+
+	return cache.Key(policyKey, ctx.Policy.GetGeneration(), canonicalizeForCache(resource), ctx.AdmissionInfo, ctx.Mode)
+}
+
+// getCachedResponse returns a previously cached EngineResponse for this
+// policy/resource combination, if the cache is enabled and has one.
+func getCachedResponse(ctx *PolicyContext) (*response.EngineResponse, bool) {
+	if ctx.ResultCache == nil || resultCacheDisabled(ctx) {
+		return nil, false
+	}
+
+	key, err := resultCacheKey(ctx)
+	if err != nil {
+		return nil, false
+	}
+
+	return ctx.ResultCache.Get(key)
+}
+
+// cacheResponse stores resp for reuse by identical future admissions of this
+// policy/resource combination, if the cache is enabled.
+func cacheResponse(ctx *PolicyContext, resp *response.EngineResponse) {
+	if ctx.ResultCache == nil || resultCacheDisabled(ctx) {
+		return
+	}
+
+	key, err := resultCacheKey(ctx)
+	if err != nil {
+		return
+	}
+
+	_ = ctx.ResultCache.Set(key, resp)
+}
+
 func buildLogger(ctx *PolicyContext) logr.Logger {
 	logger := log.Log.WithName("EngineValidate").WithValues("policy", ctx.Policy.Name)
 	if reflect.DeepEqual(ctx.NewResource, unstructured.Unstructured{}) {
@@ -82,7 +191,43 @@ func incrementErrorCount(resp *response.EngineResponse) {
 	resp.PolicyResponse.RulesErrorCount++
 }
 
-func validateResource(log logr.Logger, ctx *PolicyContext) *response.EngineResponse {
+// parallelDisableAnnotation lets a policy opt a rule set (or a foreach list)
+// out of concurrent evaluation, e.g. because a rule's context entries mutate
+// shared state that isn't safe to touch from multiple goroutines at once.
+const parallelDisableAnnotation = "policies.kyverno.io/disable-parallel-rules"
+
+// maxRuleWorkers bounds worker-pool concurrency for rule and foreach
+// evaluation, so a policy with dozens of rules can't monopolize every core
+// available to the webhook.
+const maxRuleWorkers = 8
+
+// workerPoolSize scales with GOMAXPROCS, capped at maxRuleWorkers.
+func workerPoolSize() int {
+	n := runtime.GOMAXPROCS(0)
+	if n > maxRuleWorkers {
+		n = maxRuleWorkers
+	}
+
+	if n < 1 {
+		n = 1
+	}
+
+	return n
+}
+
+// parallelDisabled reports whether the policy opted out of concurrent rule/
+// foreach evaluation via parallelDisableAnnotation.
+func parallelDisabled(ctx *PolicyContext) bool {
+	_, disabled := ctx.Policy.GetAnnotations()[parallelDisableAnnotation]
+	return disabled
+}
+
+type ruleResult struct {
+	resp      *response.RuleResponse
+	startTime time.Time
+}
+
+func validateResource(spanCtx context.Context, log logr.Logger, ctx *PolicyContext) *response.EngineResponse {
 	resp := &response.EngineResponse{}
 	if ManagedPodResource(ctx.Policy, ctx.NewResource) {
 		log.V(5).Info("skip validation of pods managed by workload controllers", "policy", ctx.Policy.GetName())
@@ -92,31 +237,94 @@ func validateResource(log logr.Logger, ctx *PolicyContext) *response.EngineRespo
 	ctx.JSONContext.Checkpoint()
 	defer ctx.JSONContext.Restore()
 
-	for _, rule := range ctx.Policy.Spec.Rules {
+	sequential := parallelDisabled(ctx)
+	rules := ctx.Policy.Spec.Rules
+	results := make([]*ruleResult, len(rules))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, workerPoolSize())
+
+	for i, rule := range rules {
 		if !rule.HasValidate() {
 			continue
 		}
 
-		log = log.WithValues("rule", rule.Name)
-		if !matches(log, rule, ctx) {
+		ruleLog := log.WithValues("rule", rule.Name)
+		if !matches(ruleLog, rule, ctx) {
 			continue
 		}
 
-		log.V(3).Info("matched validate rule")
-		ctx.JSONContext.Reset()
-		startTime := time.Now()
+		ruleLog.V(3).Info("matched validate rule")
+
+		i, rule := i, rule
+		evaluate := func() {
+			// Independent rules each get their own JSONContext clone so
+			// Checkpoint/Reset/Restore calls from concurrent workers can't
+			// race; a policy can opt out via parallelDisableAnnotation if a
+			// rule needs to observe state left behind by an earlier one.
+			ruleCtx := ctx
+			if !sequential {
+				ruleCtx = ctx.DeepCopy()
+			}
+
+			ruleCtx.JSONContext.Reset()
+			startTime := time.Now()
+
+			ruleSpanCtx, ruleSpan := tracer.Start(spanCtx, "rule", trace.WithAttributes(
+				attribute.String("rule.name", rule.Name),
+				attribute.String("resource.kind", ruleCtx.NewResource.GetKind()),
+				attribute.String("resource.namespace", ruleCtx.NewResource.GetNamespace()),
+				attribute.String("resource.name", ruleCtx.NewResource.GetName()),
+			))
+
+			ruleResp := processValidationRule(ruleSpanCtx, ruleLog, ruleCtx, &rule)
+			if ruleResp != nil {
+				ruleSpan.SetAttributes(
+					attribute.String("rule.status", fmt.Sprintf("%v", ruleResp.Status)),
+					attribute.Int64("rule.processing_time_ms", time.Since(startTime).Milliseconds()),
+				)
+				metrics.RecordRuleResult(ctx.Policy.GetName(), rule.Name, fmt.Sprintf("%v", ruleResp.Status))
+			}
+			ruleSpan.End()
 
-		ruleResp := processValidationRule(log, ctx, &rule)
-		if ruleResp != nil {
-			addRuleResponse(log, resp, ruleResp, startTime)
+			results[i] = &ruleResult{resp: ruleResp, startTime: startTime}
+		}
+
+		if sequential {
+			evaluate()
+			continue
 		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			evaluate()
+		}()
+	}
+
+	wg.Wait()
+
+	// Results are appended in rule order regardless of completion order, so
+	// PolicyResponse.Rules stays deterministic whether or not rules ran
+	// concurrently.
+	for _, r := range results {
+		if r == nil || r.resp == nil {
+			continue
+		}
+
+		addRuleResponse(log, resp, r.resp, r.startTime)
 	}
 
 	return resp
 }
 
-func processValidationRule(log logr.Logger, ctx *PolicyContext, rule *kyverno.Rule) *response.RuleResponse {
-	v := newValidator(log, ctx, rule)
+func processValidationRule(spanCtx context.Context, log logr.Logger, ctx *PolicyContext, rule *kyverno.Rule) *response.RuleResponse {
+	spanCtx, span := tracer.Start(spanCtx, "processValidationRule")
+	defer span.End()
+
+	v := newValidator(spanCtx, log, ctx, rule)
 	if rule.Validation.ForEachValidation != nil {
 		return v.validateForEach()
 	}
@@ -138,7 +346,17 @@ func addRuleResponse(log logr.Logger, resp *response.EngineResponse, ruleResp *r
 	resp.PolicyResponse.Rules = append(resp.PolicyResponse.Rules, *ruleResp)
 }
 
+// expressionEngine identifies which expression language a rule's
+// preconditions, deny conditions and foreach list are written in. It
+// defaults to jmespath when a rule does not set one, preserving existing
+// policies.
+const (
+	expressionEngineJMESPath = "jmespath"
+	expressionEngineCEL      = "cel"
+)
+
 type validator struct {
+	spanCtx          context.Context
 	log              logr.Logger
 	ctx              *PolicyContext
 	rule             *kyverno.Rule
@@ -147,11 +365,28 @@ type validator struct {
 	pattern          apiextensions.JSON
 	anyPattern       apiextensions.JSON
 	deny             *kyverno.Deny
+	verifyImages     *kyverno.ImageVerification
+}
+
+// usesCEL reports whether the rule opted into CEL via expressionEngine: cel.
+func (v *validator) usesCEL() bool {
+	return strings.EqualFold(string(v.rule.ExpressionEngine), expressionEngineCEL)
 }
 
-func newValidator(log logr.Logger, ctx *PolicyContext, rule *kyverno.Rule) *validator {
+// celObject returns the document CEL expressions are evaluated against: the
+// new resource, or the old resource on delete.
+func (v *validator) celObject() map[string]interface{} {
+	if !reflect.DeepEqual(v.ctx.NewResource, unstructured.Unstructured{}) {
+		return v.ctx.NewResource.Object
+	}
+
+	return v.ctx.OldResource.Object
+}
+
+func newValidator(spanCtx context.Context, log logr.Logger, ctx *PolicyContext, rule *kyverno.Rule) *validator {
 	ruleCopy := rule.DeepCopy()
 	return &validator{
+		spanCtx:          spanCtx,
 		log:              log,
 		rule:             ruleCopy,
 		ctx:              ctx,
@@ -160,19 +395,27 @@ func newValidator(log logr.Logger, ctx *PolicyContext, rule *kyverno.Rule) *vali
 		pattern:          ruleCopy.Validation.Pattern,
 		anyPattern:       ruleCopy.Validation.AnyPattern,
 		deny:             ruleCopy.Validation.Deny,
+		verifyImages:     ruleCopy.Validation.VerifyImages,
 	}
 }
 
-func newForeachValidator(log logr.Logger, ctx *PolicyContext, rule *kyverno.Rule) *validator {
+func newForeachValidator(spanCtx context.Context, log logr.Logger, ctx *PolicyContext, rule *kyverno.Rule) *validator {
 	ruleCopy := rule.DeepCopy()
 
-	// Variable substitution expects JSON data, so we convert to a map
-	anyAllConditions, err := common.ToMap(ruleCopy.Validation.ForEachValidation.AnyAllConditions)
-	if err != nil {
-		log.Error(err, "failed to convert ruleCopy.Validation.ForEachValidation.AnyAllConditions")
+	// A CEL foreach condition is a bare expression string, evaluated directly
+	// by checkPreconditionsCEL - converting it to a map would corrupt it, so
+	// only non-CEL rules go through the JSON-data conversion.
+	var anyAllConditions interface{} = ruleCopy.Validation.ForEachValidation.AnyAllConditions
+	if !strings.EqualFold(string(ruleCopy.ExpressionEngine), expressionEngineCEL) {
+		converted, err := common.ToMap(ruleCopy.Validation.ForEachValidation.AnyAllConditions)
+		if err != nil {
+			log.Error(err, "failed to convert ruleCopy.Validation.ForEachValidation.AnyAllConditions")
+		}
+		anyAllConditions = converted
 	}
 
 	return &validator{
+		spanCtx:          spanCtx,
 		log:              log,
 		ctx:              ctx,
 		rule:             ruleCopy,
@@ -207,9 +450,13 @@ func (v *validator) validate() *response.RuleResponse {
 	} else if v.deny != nil {
 		ruleResponse := v.validateDeny()
 		return ruleResponse
+
+	} else if v.verifyImages != nil {
+		ruleResponse := v.validateImages()
+		return ruleResponse
 	}
 
-	v.log.Info("invalid validation rule: either patterns or deny conditions are expected")
+	v.log.Info("invalid validation rule: either patterns, deny conditions or image verification are expected")
 	return nil
 }
 
@@ -239,18 +486,95 @@ func (v *validator) validateForEach() *response.RuleResponse {
 	v.ctx.JSONContext.Checkpoint()
 	defer v.ctx.JSONContext.Restore()
 
+	results := make([]*response.RuleResponse, len(elements))
+	firstErr := make([]error, len(elements))
+
+	// Outside Simulate mode, evaluation genuinely stops once any element has
+	// failed: elements not yet claimed are skipped rather than evaluated
+	// only to have their result discarded, so a rule whose context entries
+	// make a per-element APICall doesn't keep paying for those calls after
+	// the rule has already failed. Simulate mode never sets stopped, since
+	// it exists specifically to collect every element's failure in one
+	// pass.
+	var stopped int32
+	simulate := v.ctx.Mode == Simulate
+
+	evaluate := func(i int, e interface{}) {
+		r, err := v.validateForEachElement(i, e)
+		results[i], firstErr[i] = r, err
+		if !simulate && (err != nil || (r != nil && r.Status != response.RuleStatusSkip && r.Status != response.RuleStatusPass)) {
+			atomic.StoreInt32(&stopped, 1)
+		}
+	}
+
+	if parallelDisabled(v.ctx) {
+		for i, e := range elements {
+			if !simulate && atomic.LoadInt32(&stopped) != 0 {
+				break
+			}
+
+			evaluate(i, e)
+		}
+	} else {
+		// Workers claim the next unevaluated index themselves, in order,
+		// instead of each being dispatched a fixed element up front - that
+		// way a worker that's still idle when stopped is set never claims
+		// another element at all. Workers already mid-evaluation when
+		// stopped is set still run to completion; we don't have a
+		// cancellation signal to interrupt a context entry's external call
+		// mid-flight, so a handful of elements past the failure can still
+		// execute. That's a real (if bounded) cost outside Simulate mode,
+		// not a perfect short-circuit.
+		var wg sync.WaitGroup
+		var next int32
+		workers := workerPoolSize()
+		if workers > len(elements) {
+			workers = len(elements)
+		}
+
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					if !simulate && atomic.LoadInt32(&stopped) != 0 {
+						return
+					}
+
+					i := int(atomic.AddInt32(&next, 1)) - 1
+					if i >= len(elements) {
+						return
+					}
+
+					evaluate(i, elements[i])
+				}
+			}()
+		}
+
+		wg.Wait()
+	}
+
+	// Elements are inspected in list order regardless of completion order,
+	// so the rule response is the same whether or not elements ran
+	// concurrently.
 	applyCount := 0
-	for _, e := range elements {
-		v.ctx.JSONContext.Reset()
+	var subResults []response.RuleResponse
+	var firstFailure *response.RuleResponse
+
+	for i, r := range results {
+		if err := firstErr[i]; err != nil {
+			if !simulate {
+				return ruleError(v.rule, "failed to process foreach", err)
+			}
 
-		ctx := v.ctx.Copy()
-		if err := addElementToContext(ctx, e); err != nil {
-			v.log.Error(err, "failed to add element to context")
-			return ruleError(v.rule, "failed to process foreach", err)
+			errResp := ruleError(v.rule, fmt.Sprintf("foreach[%d]: failed to process element", i), err)
+			if firstFailure == nil {
+				firstFailure = errResp
+			}
+			subResults = append(subResults, *errResp)
+			continue
 		}
 
-		foreachValidator := newForeachValidator(v.log, ctx, v.rule)
-		r := foreachValidator.validate()
 		if r == nil {
 			v.log.Info("skipping rule due to empty result")
 			continue
@@ -258,13 +582,28 @@ func (v *validator) validateForEach() *response.RuleResponse {
 			v.log.Info("skipping rule as preconditions were not met")
 			continue
 		} else if r.Status != response.RuleStatusPass {
-			msg := fmt.Sprintf("validation failed in foreach rule for %v", r.Message)
-			return ruleResponse(v.rule, msg, r.Status)
+			if !simulate {
+				msg := fmt.Sprintf("validation failed in foreach rule for %v", r.Message)
+				return ruleResponse(v.rule, msg, r.Status)
+			}
+
+			if firstFailure == nil {
+				firstFailure = r
+			}
+			subResults = append(subResults, *r)
+			continue
 		}
 
 		applyCount++
 	}
 
+	if len(subResults) > 0 {
+		msg := fmt.Sprintf("validation failed in foreach rule for %v", firstFailure.Message)
+		ruleResp := ruleResponse(v.rule, msg, firstFailure.Status)
+		ruleResp.SubResults = subResults
+		return ruleResp
+	}
+
 	if applyCount == 0 {
 		return ruleResponse(v.rule, "rule skipped", response.RuleStatusSkip)
 	}
@@ -272,6 +611,31 @@ func (v *validator) validateForEach() *response.RuleResponse {
 	return ruleResponse(v.rule, "rule passed", response.RuleStatusPass)
 }
 
+// validateForEachElement runs the foreach rule against a single list
+// element. Unless the policy opted out via parallelDisableAnnotation, it
+// operates on a DeepCopy of the validator's PolicyContext so that
+// JSONContext.Reset calls from concurrent elements don't race.
+func (v *validator) validateForEachElement(i int, e interface{}) (*response.RuleResponse, error) {
+	elemCtx, elemSpan := tracer.Start(v.spanCtx, "foreach.element", trace.WithAttributes(attribute.Int("foreach.index", i)))
+	defer elemSpan.End()
+
+	baseCtx := v.ctx
+	if !parallelDisabled(v.ctx) {
+		baseCtx = v.ctx.DeepCopy()
+	}
+
+	baseCtx.JSONContext.Reset()
+
+	ctx := baseCtx.Copy()
+	if err := addElementToContext(ctx, e); err != nil {
+		v.log.Error(err, "failed to add element to context")
+		return nil, err
+	}
+
+	foreachValidator := newForeachValidator(elemCtx, v.log, ctx, v.rule)
+	return foreachValidator.validate(), nil
+}
+
 func addElementToContext(ctx *PolicyContext, e interface{}) error {
 	data, err := common.ToMap(e)
 	if err != nil {
@@ -290,7 +654,18 @@ func addElementToContext(ctx *PolicyContext, e interface{}) error {
 }
 
 func (v *validator) evaluateList(jmesPath string) ([]interface{}, error) {
+	if v.usesCEL() {
+		prg, err := cel.Compile(jmesPath)
+		if err != nil {
+			return nil, err
+		}
+
+		return prg.EvaluateList(v.celObject())
+	}
+
+	startTime := time.Now()
 	i, err := v.ctx.JSONContext.Query(jmesPath)
+	metrics.ObserveJMESPathQuery(v.ctx.Policy.GetName(), v.rule.Name, time.Since(startTime))
 	if err != nil {
 		return nil, err
 	}
@@ -304,6 +679,11 @@ func (v *validator) evaluateList(jmesPath string) ([]interface{}, error) {
 }
 
 func (v *validator) loadContext() error {
+	startTime := time.Now()
+	defer func() {
+		metrics.ObserveContextLoad(v.ctx.Policy.GetName(), v.rule.Name, time.Since(startTime))
+	}()
+
 	if err := LoadContext(v.log, v.contextEntries, v.ctx.ResourceCache, v.ctx, v.rule.Name); err != nil {
 		if _, ok := err.(gojmespath.NotFoundError); ok {
 			v.log.V(3).Info("failed to load context", "reason", err.Error())
@@ -318,6 +698,10 @@ func (v *validator) loadContext() error {
 }
 
 func (v *validator) checkPreconditions() (bool, error) {
+	if v.usesCEL() {
+		return v.checkPreconditionsCEL()
+	}
+
 	preconditions, err := variables.SubstituteAllInPreconditions(v.log, v.ctx.JSONContext, v.anyAllConditions)
 	if err != nil {
 		return false, errors.Wrapf(err, "failed to substitute variables in preconditions")
@@ -332,7 +716,36 @@ func (v *validator) checkPreconditions() (bool, error) {
 	return pass, nil
 }
 
+// checkPreconditionsCEL evaluates the rule's preconditions as a single CEL
+// expression against the resource, instead of a JMESPath condition tree.
+func (v *validator) checkPreconditionsCEL() (bool, error) {
+	if v.anyAllConditions == nil {
+		return true, nil
+	}
+
+	expr, ok := v.anyAllConditions.(string)
+	if !ok {
+		return false, errors.New("expressionEngine cel requires preconditions to be a CEL expression string")
+	}
+
+	prg, err := cel.Compile(expr)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to compile CEL preconditions")
+	}
+
+	pass, err := prg.EvaluateBool(v.celObject())
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to evaluate CEL preconditions")
+	}
+
+	return pass, nil
+}
+
 func (v *validator) validateDeny() *response.RuleResponse {
+	if v.usesCEL() {
+		return v.validateDenyCEL()
+	}
+
 	anyAllCond := v.deny.AnyAllConditions
 	anyAllCond, err := variables.SubstituteAll(v.log, v.ctx.JSONContext, anyAllCond)
 	if err != nil {
@@ -356,6 +769,108 @@ func (v *validator) validateDeny() *response.RuleResponse {
 	return ruleResponse(v.rule, v.getDenyMessage(deny), response.RuleStatusPass)
 }
 
+// validateDenyCEL evaluates the rule's deny condition as a CEL expression
+// rather than a JMESPath condition tree.
+func (v *validator) validateDenyCEL() *response.RuleResponse {
+	expr, ok := v.deny.AnyAllConditions.(string)
+	if !ok {
+		return ruleError(v.rule, "invalid deny conditions", errors.New("expressionEngine cel requires deny conditions to be a CEL expression string"))
+	}
+
+	prg, err := cel.Compile(expr)
+	if err != nil {
+		return ruleError(v.rule, "failed to compile CEL deny conditions", err)
+	}
+
+	deny, err := prg.EvaluateBool(v.celObject())
+	if err != nil {
+		return ruleError(v.rule, "failed to evaluate CEL deny conditions", err)
+	}
+
+	return ruleResponse(v.rule, v.getDenyMessage(deny), denyStatus(deny))
+}
+
+func denyStatus(deny bool) response.RuleStatus {
+	if deny {
+		return response.RuleStatusFail
+	}
+
+	return response.RuleStatusPass
+}
+
+// validateImages resolves image references via the rule's configured (or
+// default) JMESPath expressions, verifies each against the rule's attestors,
+// and, on success and unless the rule sets mutateDigest to false, rewrites
+// the image references in v.ctx.NewResource to their verified digest form.
+func (v *validator) validateImages() *response.RuleResponse {
+	resource := v.ctx.NewResource.Object
+	digestByRef := map[string]string{}
+	verified := 0
+
+	for _, path := range images.Paths(v.verifyImages.ImageReferences) {
+		refs, err := imageRefsAt(resource, path)
+		if err != nil {
+			return ruleError(v.rule, fmt.Sprintf("failed to resolve images at %s", path), err)
+		}
+
+		if len(refs) == 0 {
+			continue
+		}
+
+		results, err := images.Verify(refs, *v.verifyImages)
+		if err != nil {
+			return ruleResponse(v.rule, fmt.Sprintf("image verification failed at %s: %s", path, err.Error()), response.RuleStatusFail)
+		}
+
+		for _, r := range results {
+			digestByRef[r.Image] = r.Digest
+		}
+
+		verified += len(results)
+	}
+
+	// Rewriting resource to its digest-pinned form is opt-out, not
+	// mandatory: some policies only want verification enforced and don't
+	// want the resource touched. Safe to mutate resource in place here -
+	// it's v.ctx.NewResource.Object, and outside parallelDisabled mode every
+	// rule already runs against its own PolicyContext.DeepCopy(), so this
+	// rule's mutation can't race another rule's read of the same map.
+	if v.verifyImages.MutateDigestEnabled() {
+		images.SetDigests(resource, digestByRef)
+	}
+
+	msg := fmt.Sprintf("validation rule '%s' passed, %d image(s) verified.", v.rule.Name, verified)
+	return ruleResponse(v.rule, msg, response.RuleStatusPass)
+}
+
+// imageRefsAt resolves a JMESPath expression against the resource and
+// returns the matched image references as strings.
+func imageRefsAt(resource map[string]interface{}, path string) ([]string, error) {
+	matched, err := gojmespath.Search(path, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []interface{}
+	switch m := matched.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		matches = m
+	default:
+		matches = []interface{}{m}
+	}
+
+	refs := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if s, ok := m.(string); ok && s != "" {
+			refs = append(refs, s)
+		}
+	}
+
+	return refs, nil
+}
+
 func (v *validator) getDenyMessage(deny bool) string {
 	if !deny {
 		return fmt.Sprintf("validation rule '%s' passed.", v.rule.Name)
@@ -455,6 +970,7 @@ func (v *validator) validatePatterns(resource unstructured.Unstructured) *respon
 
 	if v.anyPattern != nil {
 		var failedAnyPatternsErrors []error
+		var subResults []response.RuleResponse
 		var err error
 
 		anyPatterns, err := deserializeAnyPattern(v.anyPattern)
@@ -464,7 +980,9 @@ func (v *validator) validatePatterns(resource unstructured.Unstructured) *respon
 		}
 
 		for idx, pattern := range anyPatterns {
+			_, patternSpan := tracer.Start(v.spanCtx, "pattern.match", trace.WithAttributes(attribute.Int("pattern.index", idx)))
 			err := validate.MatchPattern(v.log, resource.Object, pattern)
+			patternSpan.End()
 			if err == nil {
 				msg := fmt.Sprintf("validation rule '%s' anyPattern[%d] passed.", v.rule.Name, idx)
 				return ruleResponse(v.rule, msg, response.RuleStatusPass)
@@ -472,12 +990,20 @@ func (v *validator) validatePatterns(resource unstructured.Unstructured) *respon
 
 			if pe, ok := err.(*validate.PatternError); ok {
 				v.log.V(3).Info("validation rule failed", "anyPattern[%d]", idx, "path", pe.Path)
+
+				var patternErr error
+				var subMsg string
 				if pe.Path == "" {
-					patternErr := fmt.Errorf("Rule %s[%d] failed: %s.", v.rule.Name, idx, err.Error())
-					failedAnyPatternsErrors = append(failedAnyPatternsErrors, patternErr)
+					patternErr = fmt.Errorf("Rule %s[%d] failed: %s.", v.rule.Name, idx, err.Error())
+					subMsg = fmt.Sprintf("anyPattern[%d] failed: %s", idx, err.Error())
 				} else {
-					patternErr := fmt.Errorf("Rule %s[%d] failed at path %s.", v.rule.Name, idx, pe.Path)
-					failedAnyPatternsErrors = append(failedAnyPatternsErrors, patternErr)
+					patternErr = fmt.Errorf("Rule %s[%d] failed at path %s.", v.rule.Name, idx, pe.Path)
+					subMsg = fmt.Sprintf("anyPattern[%d] failed at path %s", idx, pe.Path)
+				}
+
+				failedAnyPatternsErrors = append(failedAnyPatternsErrors, patternErr)
+				if v.ctx.Mode == Simulate {
+					subResults = append(subResults, *ruleResponse(v.rule, subMsg, response.RuleStatusFail))
 				}
 			}
 		}
@@ -491,7 +1017,9 @@ func (v *validator) validatePatterns(resource unstructured.Unstructured) *respon
 
 			v.log.V(4).Info(fmt.Sprintf("Validation rule '%s' failed. %s", v.rule.Name, errorStr))
 			msg := buildAnyPatternErrorMessage(v.rule, errorStr)
-			return ruleResponse(v.rule, msg, response.RuleStatusFail)
+			ruleResp := ruleResponse(v.rule, msg, response.RuleStatusFail)
+			ruleResp.SubResults = subResults
+			return ruleResp
 		}
 	}
 
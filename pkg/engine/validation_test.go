@@ -0,0 +1,138 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/go-logr/logr"
+	kyverno "github.com/kyverno/kyverno/pkg/api/kyverno/v1"
+	"github.com/kyverno/kyverno/pkg/engine/response"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// countingJSONContext is a minimal JSONContext fake that records how many
+// foreach elements actually reached evaluation, via AddResourceAsObject -
+// every foreach element calls it exactly once, regardless of which
+// validation branch (pattern/deny/CEL) it takes.
+type countingJSONContext struct {
+	mu       sync.Mutex
+	elements []interface{}
+}
+
+func (c *countingJSONContext) Checkpoint() {}
+func (c *countingJSONContext) Reset()      {}
+func (c *countingJSONContext) Restore()    {}
+
+func (c *countingJSONContext) Query(string) (interface{}, error) {
+	return nil, nil
+}
+
+func (c *countingJSONContext) AddResourceAsObject(resource interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.elements = append(c.elements, resource)
+	return nil
+}
+
+func (c *countingJSONContext) Copy() JSONContext {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cp := &countingJSONContext{elements: append([]interface{}(nil), c.elements...)}
+	return cp
+}
+
+func (c *countingJSONContext) evaluatedCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.elements)
+}
+
+// foreachRule builds a rule whose foreach elements are validated with a CEL
+// deny condition (object.fail == true).
+func foreachRule() kyverno.Rule {
+	return kyverno.Rule{
+		Name:             "check-elements",
+		ExpressionEngine: kyverno.CELEngine,
+		Validation: kyverno.Validation{
+			ForEachValidation: &kyverno.ForEachValidation{
+				List: "object.items",
+				Deny: &kyverno.Deny{
+					AnyAllConditions: "object.fail == true",
+				},
+			},
+		},
+	}
+}
+
+func newTestPolicyContext(rule kyverno.Rule, items []interface{}, mode PolicyExecutionMode) *PolicyContext {
+	resource := unstructured.Unstructured{}
+	resource.SetUnstructuredContent(map[string]interface{}{
+		"items": items,
+	})
+
+	policy := ClusterPolicy{}
+	policy.SetAnnotations(map[string]string{parallelDisableAnnotation: "true"})
+	policy.Spec = PolicySpec{Rules: []kyverno.Rule{rule}}
+
+	return &PolicyContext{
+		Policy:      policy,
+		NewResource: resource,
+		JSONContext: &countingJSONContext{},
+		Mode:        mode,
+	}
+}
+
+func TestValidateForEach_ShortCircuitsOutsideSimulate(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"fail": false},
+		map[string]interface{}{"fail": true},
+		map[string]interface{}{"fail": false},
+		map[string]interface{}{"fail": false},
+		map[string]interface{}{"fail": false},
+	}
+
+	rule := foreachRule()
+	ctx := newTestPolicyContext(rule, items, Enforce)
+
+	v := newForeachValidator(context.Background(), logr.Discard(), ctx, &rule)
+	resp := v.validateForEach()
+
+	if resp == nil || resp.Status != response.RuleStatusFail {
+		t.Fatalf("expected rule to fail at the second element, got %+v", resp)
+	}
+
+	evaluated := ctx.JSONContext.(*countingJSONContext).evaluatedCount()
+	if evaluated >= len(items) {
+		t.Fatalf("expected evaluation to stop before the end of the list, but all %d elements ran", evaluated)
+	}
+}
+
+func TestValidateForEach_SimulateEvaluatesEveryElementAndCollectsFailures(t *testing.T) {
+	items := []interface{}{
+		map[string]interface{}{"fail": false},
+		map[string]interface{}{"fail": true},
+		map[string]interface{}{"fail": false},
+		map[string]interface{}{"fail": true},
+		map[string]interface{}{"fail": false},
+	}
+
+	rule := foreachRule()
+	ctx := newTestPolicyContext(rule, items, Simulate)
+
+	v := newForeachValidator(context.Background(), logr.Discard(), ctx, &rule)
+	resp := v.validateForEach()
+
+	if resp == nil || resp.Status != response.RuleStatusFail {
+		t.Fatalf("expected overall rule status to be fail, got %+v", resp)
+	}
+
+	if len(resp.SubResults) != 2 {
+		t.Fatalf("expected both failing elements to be collected as sub-results, got %d", len(resp.SubResults))
+	}
+
+	evaluated := ctx.JSONContext.(*countingJSONContext).evaluatedCount()
+	if evaluated != len(items) {
+		t.Fatalf("expected Simulate mode to evaluate every element (%d), got %d", len(items), evaluated)
+	}
+}